@@ -1,9 +1,13 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"go/ast"
+	"go/printer"
+	"go/token"
 	"go/types"
+	"strconv"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/singlechecker"
@@ -30,6 +34,11 @@ func run(pass *analysis.Pass) (interface{}, error) {
 
 			emittedShouldFix := checkNilnessAssertions(*ass, pass)
 			checkStyle(*ass, pass, !emittedShouldFix)
+			checkAsyncAssertions(*ass, pass)
+			checkRedundantMatchers(*ass, pass)
+			checkLenSubject(*ass, pass)
+			checkMatchError(*ass, pass)
+			checkNotWrapper(*ass, pass)
 
 			// FIXME what should we return here? Can assertions be nested?
 			return true
@@ -39,17 +48,35 @@ func run(pass *analysis.Pass) (interface{}, error) {
 	return nil, nil
 }
 
-// assertion describes a `Ω(X).Should(Y)`-like call
+// assertion describes a `Ω(X).Should(Y)`-like call, or an async variant
+// such as `Eventually(X).Should(Y)`
 type assertion struct {
 	*ast.CallExpr            // whole
-	Omega         *ast.Ident // Ω part
+	Omega         *ast.Ident // Ω / Eventually / Consistently part
 	Subject       ast.Expr   // X part
 	Should        *ast.Ident // Should part
 	Matcher       ast.Expr   // Y part
 	Negated       bool       // whether the matcher is negated (eg. when using `ShouldNot`)
+	Async         bool       // whether Omega is Eventually/Consistently rather than Ω/Expect
+	Explanation   []ast.Expr // optional description/format args following the matcher
+}
+
+// assertionStyle returns the Should-style/Expect-style this assertion is
+// written in. For Ω/Expect it's dictated by the Omega part, but Eventually
+// and Consistently accept both Should and To, so fall back to the Should
+// part itself.
+func assertionStyle(ass assertion) Style {
+	if ass.Async {
+		return getStyle(ass.Should.Name)
+	}
+	return getStyle(ass.Omega.Name)
 }
 
 func checkStyle(ass assertion, pass *analysis.Pass, emitFixes bool) {
+	if ass.Async {
+		return
+	}
+
 	if getStyle(ass.Omega.Name) == getStyle(ass.Should.Name) {
 		return
 	}
@@ -80,6 +107,8 @@ func checkStyle(ass assertion, pass *analysis.Pass, emitFixes bool) {
 
 const Omega = "Ω"
 const Expect = "Expect"
+const Eventually = "Eventually"
+const Consistently = "Consistently"
 const Should = "Should"
 const ShouldNot = "ShouldNot"
 const To = "To"
@@ -103,6 +132,17 @@ func checkNilnessAssertions(ass assertion, pass *analysis.Pass) (emittedShouldFi
 		return false
 	}
 
+	if ass.Async {
+		// checkAsyncAssertions owns nilness-style reasoning for Eventually/
+		// Consistently entirely: for a func/chan subject, ass.Subject is the
+		// polled thing, not the error it yields, so isErrorExpr below would
+		// look at the wrong type; for any other subject, checkAsyncAssertions
+		// already reports it as an unpollable plain value, and piling a
+		// nilness suggestion on top of that would just be a second,
+		// contradictory diagnostic on the same assertion.
+		return false
+	}
+
 	var expectedMatcher KnownMatcher
 	if isErrorExpr(ass.Subject, pass.TypesInfo) {
 		if _, isCall := ass.Subject.(*ast.CallExpr); isCall {
@@ -118,36 +158,555 @@ func checkNilnessAssertions(ass assertion, pass *analysis.Pass) (emittedShouldFi
 		return false
 	}
 
-	d := analysis.Diagnostic{
+	needsInverting := matchesNil(matcher) != matchesNil(expectedMatcher)
+	return reportMatcherRewrite(ass, pass, string(matcher), string(expectedMatcher), matcherIdent.Pos(), matcherIdent.End(), needsInverting)
+}
+
+// checkAsyncAssertions lints Eventually/Consistently-specific misuse: polling
+// a value that can never change, and polling a func() error with the wrong
+// nilness matcher.
+func checkAsyncAssertions(ass assertion, pass *analysis.Pass) {
+	if !ass.Async {
+		return
+	}
+
+	subjectType := pass.TypesInfo.TypeOf(ass.Subject)
+	if subjectType == nil {
+		return
+	}
+
+	switch underlying := subjectType.Underlying().(type) {
+	case *types.Signature:
+		if !returnsError(underlying) {
+			return
+		}
+		matcherIdent, matcher := getKnownMatcher(ass)
+		if matcher == IsNil || matcher == HaveOccurred {
+			needsInverting := matchesNil(matcher) != matchesNil(Succeed)
+			reportMatcherRewrite(ass, pass, string(matcher), string(Succeed), matcherIdent.Pos(), matcherIdent.End(), needsInverting)
+		}
+	case *types.Chan:
+		// polling a channel is fine
+	default:
+		pass.Report(analysis.Diagnostic{
+			Pos: ass.Subject.Pos(),
+			End: ass.Subject.End(),
+			Message: fmt.Sprintf(
+				"%s is polling a plain value, which can never change; pass a func() or a channel instead",
+				ass.Omega.Name,
+			),
+		})
+	}
+}
+
+// returnsError returns whether sig's last result implements error, as in
+// `func() error` or `func() (T, error)`.
+func returnsError(sig *types.Signature) bool {
+	results := sig.Results()
+	if results == nil || results.Len() == 0 {
+		return false
+	}
+	last := results.At(results.Len() - 1)
+	return types.Implements(last.Type(), errorInterface)
+}
+
+// checkRedundantMatchers lints overly generic matchers (Equal, BeEquivalentTo,
+// BeNumerically) that have a more idiomatic, specific equivalent in gomega's
+// matchers/ package.
+func checkRedundantMatchers(ass assertion, pass *analysis.Pass) {
+	if ass.Async {
+		// the empty-container, zero and BeEquivalentTo rules below reason
+		// about ass.Subject's own type, which for Eventually/Consistently is
+		// the polled func/chan/value, not the thing ultimately compared; see
+		// checkNilnessAssertions for why that can't be reused here either.
+		// Async assertions are skipped for every rule here, including the
+		// ones that don't look at the subject
+		return
+	}
+
+	name, call, ok := matcherCall(ass)
+	if !ok {
+		return
+	}
+
+	switch name {
+	case "Equal":
+		checkRedundantEqual(ass, pass, call)
+	case "BeEquivalentTo":
+		checkRedundantBeEquivalentTo(ass, pass, call)
+	case "BeNumerically":
+		checkRedundantBeNumerically(ass, pass, call)
+	}
+}
+
+func checkRedundantEqual(ass assertion, pass *analysis.Pass, call *ast.CallExpr) {
+	if len(call.Args) != 1 {
+		return
+	}
+	arg := call.Args[0]
+
+	if ident, ok := arg.(*ast.Ident); ok && (ident.Name == "true" || ident.Name == "false") {
+		value := ident.Name == "true"
+		invert := false
+		if ass.Negated {
+			// ShouldNot(Equal(v)) reads better inverted, rather than stacking negations
+			value = !value
+			invert = true
+		}
+		replacement := "BeFalse()"
+		if value {
+			replacement = "BeTrue()"
+		}
+		reportMatcherRewrite(ass, pass, "Equal", replacement, call.Pos(), call.End(), invert)
+		return
+	}
+
+	if ident, ok := arg.(*ast.Ident); ok && ident.Name == "nil" {
+		reportMatcherRewrite(ass, pass, "Equal", "BeNil()", call.Pos(), call.End(), false)
+		return
+	}
+
+	if isEmptyLiteral(arg) && isContainerExpr(ass.Subject, pass.TypesInfo) {
+		reportMatcherRewrite(ass, pass, "Equal", "BeEmpty()", call.Pos(), call.End(), false)
+		return
+	}
+
+	if isZeroLiteral(arg) && isNumericExpr(ass.Subject, pass.TypesInfo) && !isLenCall(ass.Subject) {
+		reportMatcherRewrite(ass, pass, "Equal", "BeZero()", call.Pos(), call.End(), false)
+		return
+	}
+}
+
+func checkRedundantBeEquivalentTo(ass assertion, pass *analysis.Pass, call *ast.CallExpr) {
+	if len(call.Args) != 1 {
+		return
+	}
+
+	subjectType := pass.TypesInfo.TypeOf(ass.Subject)
+	argType := pass.TypesInfo.TypeOf(call.Args[0])
+	if subjectType == nil || argType == nil || !types.Identical(subjectType, argType) {
+		return
+	}
+
+	replacement := fmt.Sprintf("Equal(%s)", renderExpr(pass.Fset, call.Args[0]))
+	reportMatcherRewrite(ass, pass, "BeEquivalentTo", replacement, call.Pos(), call.End(), false)
+}
+
+func checkRedundantBeNumerically(ass assertion, pass *analysis.Pass, call *ast.CallExpr) {
+	if len(call.Args) != 2 || isLenCall(ass.Subject) {
+		return
+	}
+
+	op, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || op.Kind != token.STRING {
+		return
+	}
+	unquoted, err := strconv.Unquote(op.Value)
+	if err != nil {
+		return
+	}
+
+	switch unquoted {
+	case "==":
+		replacement := fmt.Sprintf("Equal(%s)", renderExpr(pass.Fset, call.Args[1]))
+		reportMatcherRewrite(ass, pass, "BeNumerically", replacement, call.Pos(), call.End(), false)
+	}
+}
+
+// checkLenSubject lints assertions whose subject is len(x)/cap(x), rewriting
+// both the subject and the matcher together to the HaveLen/BeEmpty
+// equivalent applied to x directly.
+func checkLenSubject(ass assertion, pass *analysis.Pass) {
+	if ass.Async {
+		// a len(x)/cap(x) subject here is always the polled value, which
+		// checkAsyncAssertions already flags as a plain value that can
+		// never change; don't also suggest rewriting it
+		return
+	}
+
+	lenCall, ok := ass.Subject.(*ast.CallExpr)
+	if !ok || !isLenCall(ass.Subject) {
+		return
+	}
+
+	name, call, ok := matcherCall(ass)
+	if !ok {
+		return
+	}
+
+	var replacement string
+	switch name {
+	case "Equal":
+		if len(call.Args) != 1 {
+			return
+		}
+		if isZeroLiteral(call.Args[0]) {
+			replacement = "BeEmpty()"
+		} else {
+			replacement = fmt.Sprintf("HaveLen(%s)", renderExpr(pass.Fset, call.Args[0]))
+		}
+	case "BeZero":
+		if len(call.Args) != 0 {
+			return
+		}
+		replacement = "BeEmpty()"
+	case "BeNumerically":
+		if len(call.Args) != 2 {
+			return
+		}
+		op, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || op.Kind != token.STRING {
+			return
+		}
+		unquoted, err := strconv.Unquote(op.Value)
+		if err != nil {
+			return
+		}
+		switch {
+		case unquoted == "==":
+			replacement = fmt.Sprintf("HaveLen(%s)", renderExpr(pass.Fset, call.Args[1]))
+		case unquoted == ">" && isZeroLiteral(call.Args[1]):
+			replacement = "Not(BeEmpty())"
+		default:
+			return
+		}
+	default:
+		return
+	}
+
+	underlying := lenCall.Args[0]
+	underlyingText := renderExpr(pass.Fset, underlying)
+
+	pass.Report(analysis.Diagnostic{
 		Pos: ass.Pos(),
 		End: ass.End(),
 		Message: fmt.Sprintf(
-			"unidiomatic matcher: consider using %s instead of %s in this assertion",
-			expectedMatcher, matcher,
+			"unidiomatic matcher: consider using %s on %s instead of %s on its length",
+			replacement, underlyingText, name,
 		),
 		SuggestedFixes: []analysis.SuggestedFix{{
-			Message: fmt.Sprintf("change matcher to %s", expectedMatcher),
+			Message: fmt.Sprintf("change to %s", replacement),
+			TextEdits: []analysis.TextEdit{
+				{
+					Pos:     ass.Subject.Pos(),
+					End:     ass.Subject.End(),
+					NewText: []byte(underlyingText),
+				},
+				{
+					Pos:     ass.Matcher.Pos(),
+					End:     ass.Matcher.End(),
+					NewText: []byte(replacement),
+				},
+			},
+		}},
+	})
+}
+
+// checkMatchError lints two MatchError-related misuses: comparing errors
+// with Equal (which misses wrapped errors), and passing MatchError a value
+// it can never match against.
+func checkMatchError(ass assertion, pass *analysis.Pass) {
+	name, call, ok := matcherCall(ass)
+	if !ok {
+		return
+	}
+
+	switch name {
+	case "Equal":
+		checkEqualOnErrorSubject(ass, pass, call)
+	case "MatchError":
+		checkMatchErrorArg(ass, pass, call)
+	}
+}
+
+func checkEqualOnErrorSubject(ass assertion, pass *analysis.Pass, call *ast.CallExpr) {
+	if ass.Async {
+		// ass.Subject is the polled func/chan/value here, not necessarily
+		// the error ultimately compared, so skip it instead of guessing.
+		// checkAsyncAssertions reports a plain-value subject, but doesn't
+		// look at Equal for a func or channel subject either
+		return
+	}
+
+	if len(call.Args) != 1 {
+		return
+	}
+	if !isErrorExpr(ass.Subject, pass.TypesInfo) || !isErrorExpr(call.Args[0], pass.TypesInfo) {
+		return
+	}
+
+	matcherIdent, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos: ass.Pos(),
+		End: ass.End(),
+		Message: "Equal compares errors with reflect.DeepEqual and misses wrapped errors; " +
+			"consider MatchError instead",
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message: "change matcher to MatchError",
 			TextEdits: []analysis.TextEdit{{
 				Pos:     matcherIdent.Pos(),
 				End:     matcherIdent.End(),
-				NewText: []byte(expectedMatcher),
+				NewText: []byte("MatchError"),
 			}},
 		}},
+	})
+}
+
+// checkMatchErrorArg warns when MatchError is passed something other than an
+// error, a string or a nested matcher, since it can never match at runtime.
+func checkMatchErrorArg(ass assertion, pass *analysis.Pass, call *ast.CallExpr) {
+	if len(call.Args) != 1 {
+		return
 	}
+	arg := call.Args[0]
 
-	needsInverting := matchesNil(matcher) != matchesNil(expectedMatcher)
-	if needsInverting {
-		d.SuggestedFixes[0].TextEdits = append(d.SuggestedFixes[0].TextEdits, analysis.TextEdit{
+	if _, isMatcher := arg.(*ast.CallExpr); isMatcher {
+		// assume it's a nested matcher, eg. MatchError(ContainSubstring("boom"))
+		return
+	}
+
+	if isErrorExpr(arg, pass.TypesInfo) {
+		return
+	}
+
+	t := pass.TypesInfo.TypeOf(arg)
+	if t != nil {
+		if basic, ok := t.Underlying().(*types.Basic); ok && basic.Info()&types.IsString != 0 {
+			return
+		}
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:     arg.Pos(),
+		End:     arg.End(),
+		Message: "MatchError expects an error, a string, or a matcher; this argument can never match",
+	})
+}
+
+// checkNotWrapper recognizes `Not(inner)`/`Gomega.Not(inner)` matcher
+// wrappers (including double negation) and suggests dropping the wrapper by
+// flipping the Should/To word instead.
+func checkNotWrapper(ass assertion, pass *analysis.Pass) {
+	inner, ok := unwrapNot(ass.Matcher)
+	if !ok {
+		return
+	}
+
+	// Not(Not(inner)) cancels out to inner, with no need to invert the assertion
+	if innerInner, ok := unwrapNot(inner); ok {
+		reportUnwrapNot(ass, pass, renderExpr(pass.Fset, innerInner), false)
+		return
+	}
+
+	// Not(BeNil()) on an error subject should resolve straight to what
+	// checkNilnessAssertions would make of the unwrapped, inverted
+	// assertion (HaveOccurred() keeping the Should/To word for an error
+	// value, Succeed() with the word inverted for a call returning one),
+	// rather than a two-step fix that then needs checkNilnessAssertions to
+	// fire again. Skipped for Eventually/Consistently, where the subject
+	// isn't necessarily the error being compared (see checkNilnessAssertions).
+	if !ass.Async && isErrorExpr(ass.Subject, pass.TypesInfo) {
+		if call, ok := inner.(*ast.CallExpr); ok && len(call.Args) == 0 {
+			if ident, ok := call.Fun.(*ast.Ident); ok && KnownMatcher(ident.Name) == IsNil {
+				if _, isCall := ass.Subject.(*ast.CallExpr); isCall {
+					reportUnwrapNot(ass, pass, string(Succeed)+"()", true)
+				} else {
+					reportUnwrapNot(ass, pass, string(HaveOccurred)+"()", false)
+				}
+				return
+			}
+		}
+	}
+
+	reportUnwrapNot(ass, pass, renderExpr(pass.Fset, inner), true)
+}
+
+// unwrapNot returns the inner matcher and ok=true when m is `Not(inner)` or
+// `Gomega.Not(inner)`.
+func unwrapNot(m ast.Expr) (inner ast.Expr, ok bool) {
+	call, ok := m.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return nil, false
+	}
+
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		if fun.Name != "Not" {
+			return nil, false
+		}
+	case *ast.SelectorExpr:
+		if fun.Sel.Name != "Not" {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	return call.Args[0], true
+}
+
+// reportUnwrapNot reports that the matcher should be replaced by replacement
+// (the unwrapped inner matcher), optionally inverting the Should/To word to
+// cancel out the removed negation.
+func reportUnwrapNot(ass assertion, pass *analysis.Pass, replacement string, invert bool) {
+	edits := []analysis.TextEdit{{
+		Pos:     ass.Matcher.Pos(),
+		End:     ass.Matcher.End(),
+		NewText: []byte(replacement),
+	}}
+	fixMessage := fmt.Sprintf("replace with %s", replacement)
+	if invert {
+		edits = append(edits, analysis.TextEdit{
 			Pos:     ass.Should.Pos(),
 			End:     ass.Should.End(),
-			NewText: []byte(renderInStyle(getStyle(ass.Omega.Name), ass.Negated != needsInverting)),
+			NewText: []byte(renderInStyle(assertionStyle(ass), !ass.Negated)),
 		})
-		d.SuggestedFixes[0].Message += " and invert the assertion"
+		fixMessage += " and invert the assertion"
 	}
 
-	pass.Report(d)
+	pass.Report(analysis.Diagnostic{
+		Pos: ass.Pos(),
+		End: ass.End(),
+		Message: fmt.Sprintf(
+			"unidiomatic matcher: consider using %s instead of wrapping in Not(...)",
+			replacement,
+		),
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message:   fixMessage,
+			TextEdits: edits,
+		}},
+	})
+}
 
-	return needsInverting
+// renderExpr renders an expression back to source text, for copying
+// arbitrary subject/argument expressions verbatim into a SuggestedFix.
+func renderExpr(fset *token.FileSet, e ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, e); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
+// isEmptyLiteral returns whether e is an empty string, slice or map literal:
+// "", []T{} or map[K]V{}.
+func isEmptyLiteral(e ast.Expr) bool {
+	switch v := e.(type) {
+	case *ast.BasicLit:
+		return v.Kind == token.STRING && v.Value == `""`
+	case *ast.CompositeLit:
+		if len(v.Elts) != 0 {
+			return false
+		}
+		switch t := v.Type.(type) {
+		case *ast.ArrayType:
+			// a slice type; [N]T{} is a zero-valued array of length N, not empty
+			return t.Len == nil
+		case *ast.MapType:
+			return true
+		}
+	}
+	return false
+}
+
+// isZeroLiteral returns whether e is the integer literal 0.
+func isZeroLiteral(e ast.Expr) bool {
+	lit, ok := e.(*ast.BasicLit)
+	return ok && lit.Kind == token.INT && lit.Value == "0"
+}
+
+// isNumericExpr returns whether e's type is a numeric basic type.
+func isNumericExpr(e ast.Expr, info *types.Info) bool {
+	t := info.Types[e].Type
+	if t == nil {
+		return false
+	}
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsNumeric != 0
+}
+
+// isContainerExpr returns whether e's type is something BeEmpty() applies to:
+// a slice, array, map, channel or string.
+func isContainerExpr(e ast.Expr, info *types.Info) bool {
+	t := info.Types[e].Type
+	if t == nil {
+		return false
+	}
+	switch t.Underlying().(type) {
+	case *types.Slice, *types.Array, *types.Map, *types.Chan:
+		return true
+	}
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
+
+// isLenCall returns whether e is a call to the builtin len (or cap).
+func isLenCall(e ast.Expr) bool {
+	call, ok := e.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return false
+	}
+	ident, ok := call.Fun.(*ast.Ident)
+	return ok && (ident.Name == "len" || ident.Name == "cap")
+}
+
+// matcherCall splits the matcher into its callee name and underlying call,
+// regardless of whether it's one of the KnownMatcher set.
+func matcherCall(ass assertion) (name string, call *ast.CallExpr, ok bool) {
+	call, ok = ass.Matcher.(*ast.CallExpr)
+	if !ok {
+		return "", nil, false
+	}
+
+	ident, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return "", nil, false
+	}
+
+	return ident.Name, call, true
+}
+
+// reportMatcherRewrite reports that current should be replaced by replacement
+// (full matcher source, eg. "BeTrue()"), optionally inverting the Should/To
+// word when invert is true. It returns whether a TextEdit for the Should/To
+// word was emitted, so callers can avoid also emitting a style fix for the
+// same word in the same pass.
+func reportMatcherRewrite(ass assertion, pass *analysis.Pass, current, replacement string, matcherPos, matcherEnd token.Pos, invert bool) bool {
+	message := fmt.Sprintf(
+		"unidiomatic matcher: consider using %s instead of %s in this assertion",
+		replacement, current,
+	)
+	fixMessage := fmt.Sprintf("change matcher to %s", replacement)
+
+	edits := []analysis.TextEdit{{
+		Pos:     matcherPos,
+		End:     matcherEnd,
+		NewText: []byte(replacement),
+	}}
+	if invert {
+		edits = append(edits, analysis.TextEdit{
+			Pos:     ass.Should.Pos(),
+			End:     ass.Should.End(),
+			NewText: []byte(renderInStyle(assertionStyle(ass), !ass.Negated)),
+		})
+		fixMessage += " and invert the assertion"
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:     ass.Pos(),
+		End:     ass.End(),
+		Message: message,
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message:   fixMessage,
+			TextEdits: edits,
+		}},
+	})
+
+	return invert
 }
 
 type KnownMatcher string
@@ -163,7 +722,7 @@ func matchesNil(m KnownMatcher) bool { return m != HaveOccurred }
 
 func getAssertion(n ast.Node) *assertion {
 	call, ok := n.(*ast.CallExpr)
-	if !ok || len(call.Args) != 1 {
+	if !ok || len(call.Args) < 1 {
 		return nil
 	}
 
@@ -173,18 +732,22 @@ func getAssertion(n ast.Node) *assertion {
 	}
 
 	omegaCall, ok := shouldGetter.X.(*ast.CallExpr)
-	if !ok || len(call.Args) != 1 {
+	if !ok {
 		return nil
 	}
+	omegaCall = unwrapAsyncChain(omegaCall)
 
 	omega, ok := omegaCall.Fun.(*ast.Ident)
-	if !ok {
+	if !ok || len(omegaCall.Args) < 1 {
 		return nil
 	}
 
+	async := false
 	switch omega.Name {
 	case Omega, Expect:
 		break
+	case Eventually, Consistently:
+		async = true
 	default:
 		return nil
 	}
@@ -201,12 +764,41 @@ func getAssertion(n ast.Node) *assertion {
 	}
 
 	return &assertion{
-		CallExpr: call,
-		Omega:    omega,
-		Subject:  omegaCall.Args[0],
-		Should:   shouldGetter.Sel,
-		Negated:  negated,
-		Matcher:  call.Args[0],
+		CallExpr:    call,
+		Omega:       omega,
+		Subject:     omegaCall.Args[0],
+		Should:      shouldGetter.Sel,
+		Negated:     negated,
+		Matcher:     call.Args[0],
+		Async:       async,
+		Explanation: call.Args[1:],
+	}
+}
+
+// asyncChainMethods are the AsyncAssertion configuration methods that can
+// appear between Eventually(X)/Consistently(X) and the final Should/To, eg.
+// `Eventually(X).WithTimeout(t).WithPolling(p).Should(Y)`.
+var asyncChainMethods = map[string]bool{
+	"WithTimeout": true,
+	"WithPolling": true,
+	"WithContext": true,
+}
+
+// unwrapAsyncChain peels off trailing AsyncAssertion configuration calls to
+// find the underlying Eventually(X)/Consistently(X) call.
+func unwrapAsyncChain(call *ast.CallExpr) *ast.CallExpr {
+	for {
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !asyncChainMethods[sel.Sel.Name] {
+			return call
+		}
+
+		inner, ok := sel.X.(*ast.CallExpr)
+		if !ok {
+			return call
+		}
+
+		call = inner
 	}
 }
 
@@ -0,0 +1,31 @@
+package a
+
+// Minimal stand-in for gomega's API, just enough for the analyzer to see
+// real Should/To calls with a real error-typed subject.
+
+type Assertion struct{}
+
+func (Assertion) Should(m Matcher, args ...interface{}) bool { return true }
+func (Assertion) To(m Matcher, args ...interface{}) bool     { return true }
+
+type Matcher interface{}
+
+func Expect(actual interface{}, extra ...interface{}) Assertion { return Assertion{} }
+
+func BeNil() Matcher        { return nil }
+func HaveOccurred() Matcher { return nil }
+
+func someErr() error { return nil }
+
+func run() {
+	err := someErr()
+
+	// two args: matcher + one explanation string
+	Expect(err).To(HaveOccurred(), "should have failed")
+
+	// three args: matcher + format string + one format arg
+	Expect(err).To(BeNil(), "context: %d", 1) // want `unidiomatic matcher: consider using HaveOccurred instead of BeNil in this assertion`
+
+	// four args: matcher + format string + two format args
+	Expect(err).To(HaveOccurred(), "context: %d %s", 1, "x")
+}
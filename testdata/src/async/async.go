@@ -0,0 +1,54 @@
+package async
+
+// Minimal stand-in for gomega's async API: Eventually/Consistently plus the
+// chained WithTimeout/WithPolling/WithContext configuration methods.
+
+type Matcher interface{}
+
+type AsyncAssertion struct{}
+
+func (AsyncAssertion) Should(m Matcher, args ...interface{}) bool { return true }
+
+func (AsyncAssertion) WithTimeout(d int) AsyncAssertion           { return AsyncAssertion{} }
+func (AsyncAssertion) WithPolling(d int) AsyncAssertion           { return AsyncAssertion{} }
+func (AsyncAssertion) WithContext(ctx interface{}) AsyncAssertion { return AsyncAssertion{} }
+
+func Eventually(actual interface{}, args ...interface{}) AsyncAssertion   { return AsyncAssertion{} }
+func Consistently(actual interface{}, args ...interface{}) AsyncAssertion { return AsyncAssertion{} }
+
+func BeNil() Matcher        { return nil }
+func HaveOccurred() Matcher { return nil }
+func Succeed() Matcher      { return nil }
+func Equal(x interface{}) Matcher { return nil }
+
+func run() {
+	var err error
+	getErr := func() error { return err }
+	ch := make(chan int)
+	var x int
+
+	// a func() error subject polled with the wrong nilness matcher gets
+	// rewritten to Succeed()
+	Eventually(getErr).Should(BeNil()) // want `unidiomatic matcher: consider using Succeed instead of BeNil in this assertion`
+
+	// HaveOccurred() on a func() error subject also rewrites to Succeed(),
+	// inverting Should so the assertion keeps its original meaning
+	Eventually(getErr).Should(HaveOccurred()) // want `unidiomatic matcher: consider using Succeed instead of HaveOccurred in this assertion`
+
+	// already idiomatic: no diagnostic
+	Eventually(getErr).Should(Succeed())
+
+	// chained WithTimeout/WithPolling still resolves to the underlying
+	// Eventually(getErr) call
+	Eventually(getErr).WithTimeout(1).WithPolling(2).Should(Succeed())
+
+	// polling a channel is fine
+	Eventually(ch).Should(Equal(0))
+
+	// polling a plain value can never change; this must fire exactly once,
+	// not also trip up the sync-only checks that reason about ass.Subject's
+	// own type
+	Eventually(x).Should(Equal(0)) // want `Eventually is polling a plain value, which can never change; pass a func\(\) or a channel instead`
+
+	Consistently(x).Should(Equal(0)) // want `Consistently is polling a plain value, which can never change; pass a func\(\) or a channel instead`
+}
@@ -0,0 +1,35 @@
+package lensubject
+
+// Minimal stand-in for gomega's API, just enough to exercise
+// checkLenSubject's len(x)/cap(x) subject rewrite.
+
+type Matcher interface{}
+type Assertion struct{}
+
+func (Assertion) To(m Matcher, args ...interface{}) bool { return true }
+
+func Expect(actual interface{}, extra ...interface{}) Assertion { return Assertion{} }
+
+func Equal(x interface{}) Matcher                    { return nil }
+func BeZero() Matcher                                { return nil }
+func BeNumerically(op string, x interface{}) Matcher { return nil }
+func HaveLen(n int) Matcher                          { return nil }
+func BeEmpty() Matcher                               { return nil }
+func Not(m Matcher) Matcher                          { return nil }
+
+func run() {
+	var xs []int
+
+	Expect(len(xs)).To(Equal(3)) // want `unidiomatic matcher: consider using HaveLen\(3\) on xs instead of Equal on its length`
+
+	Expect(len(xs)).To(Equal(0)) // want `unidiomatic matcher: consider using BeEmpty\(\) on xs instead of Equal on its length`
+
+	Expect(len(xs)).To(BeZero()) // want `unidiomatic matcher: consider using BeEmpty\(\) on xs instead of BeZero on its length`
+
+	Expect(len(xs)).To(BeNumerically("==", 3)) // want `unidiomatic matcher: consider using HaveLen\(3\) on xs instead of BeNumerically on its length`
+
+	Expect(len(xs)).To(BeNumerically(">", 0)) // want `unidiomatic matcher: consider using Not\(BeEmpty\(\)\) on xs instead of BeNumerically on its length`
+
+	// not rewritten: no idiomatic equivalent for this operator
+	Expect(len(xs)).To(BeNumerically(">", 3))
+}
@@ -0,0 +1,35 @@
+package matcherror
+
+// Minimal stand-in for gomega's API, just enough to exercise checkMatchError.
+
+type Matcher interface{}
+type Assertion struct{}
+
+func (Assertion) To(m Matcher, args ...interface{}) bool { return true }
+
+func Expect(actual interface{}, extra ...interface{}) Assertion { return Assertion{} }
+
+func Equal(x interface{}) Matcher       { return nil }
+func MatchError(x interface{}) Matcher  { return nil }
+func ContainSubstring(s string) Matcher { return nil }
+
+func someErr() error { return nil }
+
+func run() {
+	err := someErr()
+	other := someErr()
+
+	Expect(err).To(Equal(other)) // want `Equal compares errors with reflect.DeepEqual and misses wrapped errors; consider MatchError instead`
+
+	// already idiomatic: no diagnostic
+	Expect(err).To(MatchError(other))
+
+	// a string target is fine
+	Expect(err).To(MatchError("boom"))
+
+	// a nested matcher is fine
+	Expect(err).To(MatchError(ContainSubstring("boom")))
+
+	// not an error, a string or a matcher: can never match
+	Expect(err).To(MatchError(42)) // want `MatchError expects an error, a string, or a matcher; this argument can never match`
+}
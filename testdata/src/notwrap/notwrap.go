@@ -0,0 +1,40 @@
+package notwrap
+
+// Minimal stand-in for gomega's API, just enough to exercise checkNotWrapper.
+
+type Matcher interface{}
+type Assertion struct{}
+
+func (Assertion) To(m Matcher, args ...interface{}) bool { return true }
+
+func Expect(actual interface{}, extra ...interface{}) Assertion { return Assertion{} }
+
+func BeNil() Matcher              { return nil }
+func Equal(x interface{}) Matcher { return nil }
+func Not(m Matcher) Matcher       { return nil }
+
+// Gomega is a stand-in for gomega's Gomega interface, whose Not method is
+// reached through a selector rather than a bare identifier.
+type Gomega struct{}
+
+func (Gomega) Not(m Matcher) Matcher { return nil }
+
+func someErr() error { return nil }
+
+func run() {
+	err := someErr()
+	var n int
+	var g Gomega
+
+	// Not(BeNil()) on an error subject resolves straight to HaveOccurred()
+	Expect(err).To(Not(BeNil())) // want `unidiomatic matcher: consider using HaveOccurred\(\) instead of wrapping in Not\(\.\.\.\)`
+
+	// Not(...) on a non-error subject just unwraps, inverting To/ToNot
+	Expect(n).To(Not(Equal(5))) // want `unidiomatic matcher: consider using Equal\(5\) instead of wrapping in Not\(\.\.\.\)`
+
+	// Gomega.Not reached through a selector is recognized the same way
+	Expect(n).To(g.Not(Equal(5))) // want `unidiomatic matcher: consider using Equal\(5\) instead of wrapping in Not\(\.\.\.\)`
+
+	// double negation cancels out, with no need to invert the assertion
+	Expect(n).To(Not(Not(Equal(5)))) // want `unidiomatic matcher: consider using Equal\(5\) instead of wrapping in Not\(\.\.\.\)`
+}
@@ -0,0 +1,50 @@
+package redundant
+
+// Minimal stand-in for gomega's API, just enough to exercise
+// checkRedundantMatchers against real-typed subjects.
+
+type Matcher interface{}
+type Assertion struct{}
+
+func (Assertion) To(m Matcher, args ...interface{}) bool    { return true }
+func (Assertion) ToNot(m Matcher, args ...interface{}) bool { return true }
+
+func Expect(actual interface{}, extra ...interface{}) Assertion { return Assertion{} }
+
+func Equal(x interface{}) Matcher                    { return nil }
+func BeTrue() Matcher                                { return nil }
+func BeFalse() Matcher                               { return nil }
+func BeNil() Matcher                                 { return nil }
+func BeEmpty() Matcher                               { return nil }
+func BeZero() Matcher                                { return nil }
+func BeEquivalentTo(x interface{}) Matcher           { return nil }
+func BeNumerically(op string, x interface{}) Matcher { return nil }
+
+func run() {
+	var b bool
+	var s string
+	var xs []int
+	var n int
+	var f32 float32
+	var other float32
+
+	Expect(b).To(Equal(true)) // want `unidiomatic matcher: consider using BeTrue\(\) instead of Equal in this assertion`
+
+	// ToNot(Equal(v)) reads better inverted, rather than stacking negations
+	Expect(b).ToNot(Equal(true)) // want `unidiomatic matcher: consider using BeFalse\(\) instead of Equal in this assertion`
+
+	Expect(s).To(Equal(nil)) // want `unidiomatic matcher: consider using BeNil\(\) instead of Equal in this assertion`
+
+	Expect(s).To(Equal("")) // want `unidiomatic matcher: consider using BeEmpty\(\) instead of Equal in this assertion`
+
+	Expect(xs).To(Equal([]int{})) // want `unidiomatic matcher: consider using BeEmpty\(\) instead of Equal in this assertion`
+
+	Expect(n).To(Equal(0)) // want `unidiomatic matcher: consider using BeZero\(\) instead of Equal in this assertion`
+
+	Expect(f32).To(BeEquivalentTo(other)) // want `unidiomatic matcher: consider using Equal\(other\) instead of BeEquivalentTo in this assertion`
+
+	Expect(n).To(BeNumerically("==", 5)) // want `unidiomatic matcher: consider using Equal\(5\) instead of BeNumerically in this assertion`
+
+	// not redundant: no idiomatic replacement for this operator
+	Expect(n).To(BeNumerically(">", 5))
+}